@@ -1,6 +1,26 @@
 package pkg
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+)
+
+// maxStackDepth bounds how many call frames are captured at error construction time.
+const maxStackDepth = 32
+
+// StackTracer is implemented by errors that recorded a call stack at construction time.
+type StackTracer interface {
+	StackTrace() []runtime.Frame
+}
+
+// stackTraceAware lets newBaseError detect a cause that already carries a stack trace,
+// so wrapping it doesn't capture a second, redundant one.
+type stackTraceAware interface {
+	hasStack() bool
+}
 
 // ErrorKind categorizes managed errors so callers can react differently to errors vs failures.
 type ErrorKind string
@@ -58,6 +78,58 @@ func WithDetail(key, value string) ErrorOption {
 	}
 }
 
+// WithoutStack skips stack trace capture, for wrappers that just pass through an existing cause.
+func WithoutStack() ErrorOption {
+	return func(b *baseError) {
+		b.noStack = true
+	}
+}
+
+// WithCauses attaches multiple underlying causes. A cause set by an earlier WithCause (or an
+// earlier WithCauses) is kept as the first cause rather than replaced; a WithCause applied
+// after WithCauses, however, replaces all of them. errors.Is/As (Go 1.20+) can then traverse
+// every one of them, since the resulting cause exposes Unwrap() []error.
+func WithCauses(errs ...error) ErrorOption {
+	return func(b *baseError) {
+		causes := make([]error, 0, len(errs)+1)
+		if b.cause != nil {
+			causes = append(causes, b.cause)
+		}
+		for _, err := range errs {
+			if err != nil {
+				causes = append(causes, err)
+			}
+		}
+		switch len(causes) {
+		case 0:
+			b.cause = nil
+		case 1:
+			b.cause = causes[0]
+		default:
+			b.cause = &joinedError{errs: causes}
+		}
+	}
+}
+
+// joinedError aggregates multiple causes behind a single error value. It implements
+// Unwrap() []error (the Go 1.20+ multi-unwrap convention) so errors.Is/As keep traversing
+// into every cause instead of stopping at the first one.
+type joinedError struct {
+	errs []error
+}
+
+func (j *joinedError) Error() string {
+	msgs := make([]string, len(j.errs))
+	for i, err := range j.errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (j *joinedError) Unwrap() []error {
+	return j.errs
+}
+
 type baseError struct {
 	message string
 	code    string
@@ -65,6 +137,8 @@ type baseError struct {
 	details map[string]string
 	cause   error
 	kind    ErrorKind
+	stack   []uintptr
+	noStack bool
 }
 
 func newBaseError(kind ErrorKind, message, code string, status int, opts ...ErrorOption) *baseError {
@@ -80,9 +154,63 @@ func newBaseError(kind ErrorKind, message, code string, status int, opts ...Erro
 			opt(b)
 		}
 	}
+	b.captureStack()
 	return b
 }
 
+// captureStack records the call stack at construction time, unless the caller opted out via
+// WithoutStack or the wrapped cause already carries one of its own.
+func (b *baseError) captureStack() {
+	if b.noStack {
+		return
+	}
+	if aware, ok := b.cause.(stackTraceAware); ok && aware.hasStack() {
+		return
+	}
+	var pcs [maxStackDepth]uintptr
+	n := runtime.Callers(4, pcs[:])
+	b.stack = pcs[:n]
+}
+
+func (b *baseError) hasStack() bool {
+	return len(b.stack) > 0
+}
+
+// StackTrace resolves the captured program counters into runtime.Frame values on demand,
+// keeping error construction itself cheap.
+func (b *baseError) StackTrace() []runtime.Frame {
+	if len(b.stack) == 0 {
+		return nil
+	}
+	frames := runtime.CallersFrames(b.stack)
+	result := make([]runtime.Frame, 0, len(b.stack))
+	for {
+		frame, more := frames.Next()
+		result = append(result, frame)
+		if !more {
+			break
+		}
+	}
+	return result
+}
+
+// Format implements fmt.Formatter so "%+v" prints the error message followed by its stack trace.
+func (b *baseError) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		io.WriteString(f, b.Error())
+		if f.Flag('+') {
+			for _, frame := range b.StackTrace() {
+				fmt.Fprintf(f, "\n\t%s\n\t\t%s:%d", frame.Function, frame.File, frame.Line)
+			}
+		}
+	case 's':
+		io.WriteString(f, b.Error())
+	case 'q':
+		fmt.Fprintf(f, "%q", b.Error())
+	}
+}
+
 func (b *baseError) Message() string {
 	return b.message
 }
@@ -114,6 +242,58 @@ func (b *baseError) Unwrap() error {
 	return b.cause
 }
 
+// Is reports a match against another ChainableError sharing the same non-empty Code, so
+// sentinels created with Sentinel can be compared by identity of meaning rather than pointer.
+func (b *baseError) Is(target error) bool {
+	chain, ok := target.(ChainableError)
+	if !ok || chain.Code() == "" {
+		return false
+	}
+	return chain.Code() == b.code
+}
+
+// As matches a *AppError or *AppFailure target even when the receiver's own concrete type
+// differs - which is exactly the case errors.As's own reflect-based assignability check can't
+// resolve, since that check only succeeds when the chain already holds a value of target's
+// exact type. Two call patterns are supported: a nil/zero target (the idiomatic
+// `var target *AppError; errors.As(err, &target)` used by Result.OnError/OnFail) matches any
+// receiver of the corresponding Kind, recovering it as that wrapper type regardless of how it
+// was actually constructed (e.g. a raw Sentinel cause); a pre-populated target (e.g. a package
+// sentinel) additionally requires the receiver's Code to equal the existing value's Code, since
+// Code is this package's identity for "is this the same business error" (see Sentinel), letting
+// it match across Kinds too. Either way, on success *target is reassigned to a thin wrapper
+// around the receiver so the caller recovers its own Details/Kind/Status. A receiver with an
+// empty Code never matches through this method.
+func (b *baseError) As(target any) bool {
+	if b.code == "" {
+		return false
+	}
+	switch ptr := target.(type) {
+	case **AppError:
+		if *ptr != nil {
+			if (*ptr).Code() != b.code {
+				return false
+			}
+		} else if b.kind != KindError {
+			return false
+		}
+		*ptr = &AppError{b}
+		return true
+	case **AppFailure:
+		if *ptr != nil {
+			if (*ptr).Code() != b.code {
+				return false
+			}
+		} else if b.kind != KindFailure {
+			return false
+		}
+		*ptr = &AppFailure{b}
+		return true
+	default:
+		return false
+	}
+}
+
 func (b *baseError) Error() string {
 	switch {
 	case b.code != "" && b.status != 0:
@@ -146,6 +326,17 @@ func (b *baseError) mergeDetails(details map[string]string) {
 	}
 }
 
+// Sentinel builds an immutable, comparable ChainableError identified solely by code, suitable
+// for package-level error values such as:
+//
+//	var ErrNotFound = pkg.Sentinel("not_found", pkg.KindError)
+//
+// Matching is by Code, not by pointer identity, so errors.Is(err, ErrNotFound) succeeds for any
+// ChainableError sharing that code, including ones produced far away with extra details attached.
+func Sentinel(code string, kind ErrorKind) ChainableError {
+	return newBaseError(kind, code, code, 0, WithoutStack())
+}
+
 // AppError represents an expected error scenario.
 type AppError struct {
 	*baseError
@@ -223,11 +414,7 @@ func From[T any](value T, err error) Result[T] {
 	if err == nil {
 		return Success(value)
 	}
-	if chain, ok := err.(ChainableError); ok {
-		return FailureResult[T](chain)
-	}
-	failure := NewFailure(err.Error(), "unexpected_failure", 500, WithCause(err))
-	return FailureResult[T](failure)
+	return FailureResult[T](wrapAsChainable(err))
 }
 
 // Then executes the callback when the current Result has no error.
@@ -246,24 +433,28 @@ func Chain[T, U any](r Result[T], fn func(T) Result[U]) Result[U] {
 	return fn(r.value)
 }
 
-// OnError executes the callback when the Result carries an AppError.
+// OnError executes the callback when the Result carries an AppError, anywhere in its cause
+// chain (including inside an aggregated failure produced by WithCauses).
 func (r Result[T]) OnError(fn func(*AppError) Result[T]) Result[T] {
 	if r.err == nil {
 		return r
 	}
-	if err, ok := r.err.(*AppError); ok {
-		return fn(err)
+	var target *AppError
+	if errors.As(r.err, &target) {
+		return fn(target)
 	}
 	return r
 }
 
-// OnFail executes the callback when the Result carries an AppFailure.
+// OnFail executes the callback when the Result carries an AppFailure, anywhere in its cause
+// chain (including inside an aggregated failure produced by WithCauses).
 func (r Result[T]) OnFail(fn func(*AppFailure) Result[T]) Result[T] {
 	if r.err == nil {
 		return r
 	}
-	if failure, ok := r.err.(*AppFailure); ok {
-		return fn(failure)
+	var target *AppFailure
+	if errors.As(r.err, &target) {
+		return fn(target)
 	}
 	return r
 }
@@ -3,6 +3,7 @@ package pkg
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -185,6 +186,178 @@ func TestFromWrapsStandardErrorAsFailure(t *testing.T) {
 	}
 }
 
+func TestStackTraceCapturedAtConstruction(t *testing.T) {
+	err := NewError("something happened", "E001", 400)
+
+	frames := err.StackTrace()
+	if len(frames) == 0 {
+		t.Fatalf("expected at least one captured frame")
+	}
+	if !strings.Contains(frames[0].Function, "TestStackTraceCapturedAtConstruction") {
+		t.Fatalf("expected top frame to be the test function, got %q", frames[0].Function)
+	}
+
+	rendered := fmt.Sprintf("%+v", err)
+	if !strings.HasPrefix(rendered, err.Error()) {
+		t.Fatalf("expected formatted output to start with the error message, got %q", rendered)
+	}
+	if !strings.Contains(rendered, "TestStackTraceCapturedAtConstruction") {
+		t.Fatalf("expected formatted output to include the call stack, got %q", rendered)
+	}
+
+	if fmt.Sprintf("%v", err) != err.Error() {
+		t.Fatalf("expected %%v without + flag to omit the stack trace")
+	}
+}
+
+func TestWithoutStackSkipsCapture(t *testing.T) {
+	err := NewError("passthrough", "E003", 400, WithoutStack())
+
+	if len(err.StackTrace()) != 0 {
+		t.Fatalf("expected no captured frames when WithoutStack is used")
+	}
+}
+
+func TestWithCauseSkipsRedundantStack(t *testing.T) {
+	cause := NewError("root cause", "E004", 400)
+	wrapper := NewFailure("wrapped", "F004", 500, WithCause(cause))
+
+	if len(wrapper.stack) != 0 {
+		t.Fatalf("expected wrapper to skip capturing its own stack when the cause already has one")
+	}
+	if len(cause.StackTrace()) == 0 {
+		t.Fatalf("expected the original cause to still carry its captured stack")
+	}
+}
+
+func TestSentinelMatchesByCode(t *testing.T) {
+	errNotFound := Sentinel("not_found", KindError)
+
+	wrapped := NewError("widget 42 not found", "not_found", 404, WithDetail("id", "42"))
+
+	if !errors.Is(wrapped, errNotFound) {
+		t.Fatalf("expected errors.Is to match sentinel by code")
+	}
+
+	other := NewError("different problem", "other_code", 400)
+	if errors.Is(other, errNotFound) {
+		t.Fatalf("did not expect errors.Is to match a different code")
+	}
+}
+
+// TestAsMatchesAcrossConcreteTypesByCode exercises baseError.As itself. A target of the exact
+// concrete type already in the chain (or a target whose pointee is the ChainableError
+// interface) is resolved entirely by errors.As's own reflect-based assignability check before
+// baseError.As is ever consulted, so it doesn't prove this method does anything; matching an
+// *AppFailure error against a pre-existing *AppError sentinel of a different concrete type does.
+func TestAsMatchesAcrossConcreteTypesByCode(t *testing.T) {
+	sentinel := NewError("sentinel placeholder", "E040", 400)
+	actual := NewFailure("actual failure", "E040", 500, WithDetail("host", "srv-9"))
+
+	target := sentinel
+	if !errors.As(actual, &target) {
+		t.Fatalf("expected errors.As to match *AppFailure against an *AppError sentinel by code")
+	}
+	if target.Details()["host"] != "srv-9" {
+		t.Fatalf("expected the matched target to carry the actual error's details, got %+v", target.Details())
+	}
+
+	mismatched := NewError("other sentinel", "E041", 400)
+	target2 := mismatched
+	if errors.As(actual, &target2) {
+		t.Fatalf("did not expect a match against a sentinel with a different code")
+	}
+}
+
+func TestWithCausesJoinsMultipleErrors(t *testing.T) {
+	first := errors.New("first cause")
+	second := NewError("second cause", "E010", 400)
+
+	failure := NewFailure("aggregate failure", "F010", 500, WithCauses(first, second))
+
+	if !errors.Is(failure, first) {
+		t.Fatalf("expected errors.Is to find the first joined cause")
+	}
+	if !errors.Is(failure, second) {
+		t.Fatalf("expected errors.Is to find the second joined cause")
+	}
+
+	var target *AppError
+	if !errors.As(failure, &target) {
+		t.Fatalf("expected errors.As to walk into the joined cause for *AppError")
+	}
+	if target.Code() != "E010" {
+		t.Fatalf("unexpected matched error code: %s", target.Code())
+	}
+}
+
+func TestResultOnErrorMatchesNestedAppError(t *testing.T) {
+	inner := NewError("inner problem", "E020", 400)
+	aggregate := NewFailure("aggregate", "F020", 500, WithCauses(inner))
+
+	called := false
+	res := FailureResult[int](aggregate).
+		OnError(func(appErr *AppError) Result[int] {
+			called = true
+			if appErr.Code() != "E020" {
+				t.Fatalf("unexpected code: %s", appErr.Code())
+			}
+			return Success(1)
+		})
+
+	if !called {
+		t.Fatalf("expected OnError to find the nested AppError")
+	}
+	if !res.IsOK() {
+		t.Fatalf("expected recovery to succeed")
+	}
+}
+
+// TestResultOnErrorRecoversSentinelCauseOfDifferentConcreteType exercises the nil-target path of
+// baseError.As - the idiomatic `var target *AppError; errors.As(...)` pattern OnError actually
+// uses - against a cause whose concrete type is neither *AppError nor *AppFailure.
+func TestResultOnErrorRecoversSentinelCauseOfDifferentConcreteType(t *testing.T) {
+	businessError := Sentinel("E500", KindError)
+	aggregate := NewFailure("aggregate", "F500", 500, WithCauses(businessError))
+
+	called := false
+	res := FailureResult[int](aggregate).
+		OnError(func(appErr *AppError) Result[int] {
+			called = true
+			if appErr.Code() != "E500" {
+				t.Fatalf("unexpected code: %s", appErr.Code())
+			}
+			return Success(1)
+		})
+
+	if !called {
+		t.Fatalf("expected OnError to recover a Sentinel-kind cause of a different concrete type")
+	}
+	if !res.IsOK() {
+		t.Fatalf("expected recovery to succeed")
+	}
+}
+
+// TestResultOnFailDoesNotMatchAnAppError guards the flip side: a nil-target match on Kind must
+// not let an *AppError satisfy OnFail.
+func TestResultOnFailDoesNotMatchAnAppError(t *testing.T) {
+	err := NewError("bad input", "E501", 400)
+
+	called := false
+	res := FailureResult[int](err).
+		OnFail(func(appFail *AppFailure) Result[int] {
+			called = true
+			return Success(1)
+		})
+
+	if called {
+		t.Fatalf("did not expect OnFail to match an AppError")
+	}
+	if res.IsOK() {
+		t.Fatalf("expected the original error to still propagate")
+	}
+}
+
 func TestFromSuccess(t *testing.T) {
 	res := From("value", nil)
 	if !res.IsOK() {
@@ -0,0 +1,76 @@
+package pkg
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// aggregateFailure wraps every failing error behind a single AppFailure, attaching them as
+// joined causes (via WithCauses) so errors.Is/As still reach each one, and recording indexed
+// sub-codes such as error.0, error.1 in Details for callers that just want to inspect messages.
+func aggregateFailure(message, code string, errs []error) *AppFailure {
+	details := make(map[string]string, len(errs))
+	for i, err := range errs {
+		details[fmt.Sprintf("error.%d", i)] = err.Error()
+	}
+	return NewFailure(message, code, 500, WithCauses(errs...), WithDetails(details))
+}
+
+// All returns a successful Result holding every value, in order, when all of rs succeeded.
+// Otherwise it returns a single AppFailure aggregating every failing error.
+func All[T any](rs ...Result[T]) Result[[]T] {
+	values := make([]T, 0, len(rs))
+	var failed []error
+	for _, r := range rs {
+		v, err := r.Value()
+		if err != nil {
+			failed = append(failed, err)
+			continue
+		}
+		values = append(values, v)
+	}
+	if len(failed) > 0 {
+		return FailureResult[[]T](aggregateFailure("not all results succeeded", "aggregate_failure", failed))
+	}
+	return Success(values)
+}
+
+// Any returns the first successful Result among rs, or an AppFailure aggregating every error
+// if all of them failed (or none were provided).
+func Any[T any](rs ...Result[T]) Result[T] {
+	var failed []error
+	for _, r := range rs {
+		v, err := r.Value()
+		if err == nil {
+			return Success(v)
+		}
+		failed = append(failed, err)
+	}
+	return FailureResult[T](aggregateFailure("no result succeeded", "aggregate_failure", failed))
+}
+
+// AllConcurrent runs every fn in its own goroutine, passing each a context derived from ctx
+// that is canceled as soon as one of them yields a KindFailure (fail-fast), while still waiting
+// for every goroutine to return before aggregating results the same way All does.
+func AllConcurrent[T any](ctx context.Context, fns ...func(context.Context) Result[T]) Result[[]T] {
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]Result[T], len(fns))
+	var wg sync.WaitGroup
+	wg.Add(len(fns))
+	for i, fn := range fns {
+		go func(i int, fn func(context.Context) Result[T]) {
+			defer wg.Done()
+			res := fn(runCtx)
+			results[i] = res
+			if _, err := res.Value(); err != nil && err.Kind() == KindFailure {
+				cancel()
+			}
+		}(i, fn)
+	}
+	wg.Wait()
+
+	return All(results...)
+}
@@ -0,0 +1,108 @@
+package pkg
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAllSucceedsWhenEveryResultIsOK(t *testing.T) {
+	res := All(Success(1), Success(2), Success(3))
+
+	val, err := res.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(val) != 3 || val[0] != 1 || val[1] != 2 || val[2] != 3 {
+		t.Fatalf("unexpected values: %+v", val)
+	}
+}
+
+func TestAllAggregatesEveryFailure(t *testing.T) {
+	first := NewError("bad input", "E200", 400)
+	second := NewError("also bad", "E201", 400)
+
+	res := All(Success(1), FailureResult[int](first), FailureResult[int](second))
+
+	_, err := res.Value()
+	if err == nil {
+		t.Fatalf("expected an aggregated failure")
+	}
+	if !errors.Is(err, first) || !errors.Is(err, second) {
+		t.Fatalf("expected errors.Is to reach every sub-error")
+	}
+	if err.Details()["error.0"] == "" || err.Details()["error.1"] == "" {
+		t.Fatalf("expected indexed sub-codes in Details, got %+v", err.Details())
+	}
+}
+
+func TestAnyReturnsFirstSuccess(t *testing.T) {
+	res := Any(FailureResult[int](NewError("nope", "E202", 400)), Success(9))
+
+	val, err := res.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if val != 9 {
+		t.Fatalf("unexpected value: %d", val)
+	}
+}
+
+func TestAnyAggregatesWhenAllFail(t *testing.T) {
+	first := NewError("nope", "E203", 400)
+	second := NewError("still nope", "E204", 400)
+
+	res := Any(FailureResult[int](first), FailureResult[int](second))
+
+	_, err := res.Value()
+	if err == nil {
+		t.Fatalf("expected an aggregated failure")
+	}
+	if !errors.Is(err, first) || !errors.Is(err, second) {
+		t.Fatalf("expected errors.Is to reach every sub-error")
+	}
+}
+
+func TestAllConcurrentSucceeds(t *testing.T) {
+	res := AllConcurrent(context.Background(),
+		func(ctx context.Context) Result[int] { return Success(1) },
+		func(ctx context.Context) Result[int] { return Success(2) },
+	)
+
+	val, err := res.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(val) != 2 || val[0] != 1 || val[1] != 2 {
+		t.Fatalf("unexpected values: %+v", val)
+	}
+}
+
+func TestAllConcurrentCancelsOnFailure(t *testing.T) {
+	canceled := make(chan struct{})
+
+	res := AllConcurrent(context.Background(),
+		func(ctx context.Context) Result[int] {
+			return FailureResult[int](NewFailure("boom", "F200", 500))
+		},
+		func(ctx context.Context) Result[int] {
+			select {
+			case <-ctx.Done():
+				close(canceled)
+				return FailureResult[int](NewFailure("canceled", "F201", 500))
+			case <-time.After(time.Second):
+				return Success(1)
+			}
+		},
+	)
+
+	if res.IsOK() {
+		t.Fatalf("expected an aggregated failure")
+	}
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatalf("expected the second goroutine's context to be canceled")
+	}
+}
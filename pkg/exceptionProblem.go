@@ -0,0 +1,96 @@
+package pkg
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// Problem is the RFC 7807 (application/problem+json) representation of a ChainableError.
+type Problem struct {
+	Type    string            `json:"type,omitempty"`
+	Title   string            `json:"title"`
+	Status  int               `json:"status,omitempty"`
+	Detail  string            `json:"detail,omitempty"`
+	Code    string            `json:"code,omitempty"`
+	Details map[string]string `json:"details,omitempty"`
+	Errors  []Problem         `json:"errors,omitempty"`
+}
+
+// MarshalJSON renders b as application/problem+json (RFC 7807), nesting any joined causes
+// under "errors" so HTTP handlers can return the same representation for every ChainableError.
+func (b *baseError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ToProblem(b))
+}
+
+// ToProblem converts any error into its Problem representation. ChainableError values carry
+// their Message/Code/Status/Details through; a plain error becomes a generic 500 detail.
+func ToProblem(err error) Problem {
+	if err == nil {
+		return Problem{}
+	}
+	chain, ok := err.(ChainableError)
+	if !ok {
+		return Problem{Title: "Internal Server Error", Status: 500, Detail: err.Error()}
+	}
+	p := Problem{
+		Title:   string(chain.Kind()),
+		Status:  chain.Status(),
+		Detail:  chain.Message(),
+		Code:    chain.Code(),
+		Details: chain.Details(),
+	}
+	for _, sub := range unwrapAll(chain.Unwrap()) {
+		p.Errors = append(p.Errors, ToProblem(sub))
+	}
+	return p
+}
+
+// unwrapAll expands err into its constituent causes, supporting both the single-cause
+// Unwrap() error convention and the Go 1.20+ Unwrap() []error convention used by joinedError.
+func unwrapAll(err error) []error {
+	if err == nil {
+		return nil
+	}
+	if multi, ok := err.(interface{ Unwrap() []error }); ok {
+		return multi.Unwrap()
+	}
+	return []error{err}
+}
+
+// FromProblem reconstructs an AppError from a Problem, typically after decoding one received
+// across a service boundary.
+func FromProblem(p Problem) *AppError {
+	return NewError(p.Detail, p.Code, p.Status, WithDetails(p.Details), WithoutStack())
+}
+
+// Renderer lets callers register a custom encoder for errors carrying a specific Code,
+// overriding the default application/problem+json rendering for just that code.
+type Renderer interface {
+	Render(err ChainableError) ([]byte, error)
+}
+
+var (
+	renderersMu sync.RWMutex
+	renderers   = map[string]Renderer{}
+)
+
+// RegisterRenderer installs renderer as the encoder used for errors whose Code() equals code.
+// Safe to call concurrently with itself and with Render.
+func RegisterRenderer(code string, renderer Renderer) {
+	renderersMu.Lock()
+	defer renderersMu.Unlock()
+	renderers[code] = renderer
+}
+
+// Render encodes err using a Renderer registered for its Code, falling back to the default
+// application/problem+json rendering (see ToProblem) when none is registered. Safe to call
+// concurrently with itself and with RegisterRenderer.
+func Render(err ChainableError) ([]byte, error) {
+	renderersMu.RLock()
+	renderer, ok := renderers[err.Code()]
+	renderersMu.RUnlock()
+	if ok {
+		return renderer.Render(err)
+	}
+	return json.Marshal(ToProblem(err))
+}
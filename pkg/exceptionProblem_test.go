@@ -0,0 +1,128 @@
+package pkg
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestToProblemFromChainableError(t *testing.T) {
+	err := NewError("widget not found", "not_found", 404, WithDetail("id", "42"))
+
+	p := ToProblem(err)
+	if p.Title != string(KindError) || p.Status != 404 || p.Detail != "widget not found" || p.Code != "not_found" {
+		t.Fatalf("unexpected problem: %+v", p)
+	}
+	if p.Details["id"] != "42" {
+		t.Fatalf("unexpected details: %+v", p.Details)
+	}
+}
+
+func TestToProblemNestsJoinedCauses(t *testing.T) {
+	first := NewError("first", "E300", 400)
+	second := NewError("second", "E301", 400)
+	aggregate := NewFailure("aggregate", "F300", 500, WithCauses(first, second))
+
+	p := ToProblem(aggregate)
+	if len(p.Errors) != 2 {
+		t.Fatalf("expected 2 nested errors, got %d", len(p.Errors))
+	}
+	if p.Errors[0].Code != "E300" || p.Errors[1].Code != "E301" {
+		t.Fatalf("unexpected nested errors: %+v", p.Errors)
+	}
+}
+
+func TestMarshalJSONRendersProblemPlusJSON(t *testing.T) {
+	err := NewError("bad input", "E302", 400)
+
+	data, marshalErr := json.Marshal(err)
+	if marshalErr != nil {
+		t.Fatalf("unexpected marshal error: %v", marshalErr)
+	}
+
+	var p Problem
+	if unmarshalErr := json.Unmarshal(data, &p); unmarshalErr != nil {
+		t.Fatalf("unexpected unmarshal error: %v", unmarshalErr)
+	}
+	if p.Code != "E302" || p.Detail != "bad input" || p.Status != 400 {
+		t.Fatalf("unexpected round-tripped problem: %+v", p)
+	}
+}
+
+func TestFromProblemRoundTrips(t *testing.T) {
+	p := Problem{Detail: "bad input", Code: "E303", Status: 400, Details: map[string]string{"field": "email"}}
+
+	err := FromProblem(p)
+	if err.Message() != "bad input" || err.Code() != "E303" || err.Status() != 400 {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if err.Details()["field"] != "email" {
+		t.Fatalf("unexpected details: %+v", err.Details())
+	}
+}
+
+func TestRenderUsesRegisteredRenderer(t *testing.T) {
+	RegisterRenderer("E304", rendererFunc(func(err ChainableError) ([]byte, error) {
+		return []byte("custom:" + err.Code()), nil
+	}))
+
+	data, err := Render(NewError("bad input", "E304", 400))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != "custom:E304" {
+		t.Fatalf("unexpected rendered output: %s", data)
+	}
+}
+
+func TestRenderFallsBackToProblemJSON(t *testing.T) {
+	data, err := Render(NewError("bad input", "E305", 400))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var p Problem
+	if unmarshalErr := json.Unmarshal(data, &p); unmarshalErr != nil {
+		t.Fatalf("expected default rendering to be valid problem+json: %v", unmarshalErr)
+	}
+	if p.Code != "E305" {
+		t.Fatalf("unexpected problem: %+v", p)
+	}
+}
+
+func TestRegisterRendererAndRenderAreConcurrencySafe(t *testing.T) {
+	err := NewError("bad input", "E306", 400)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			RegisterRenderer("E306", rendererFunc(func(err ChainableError) ([]byte, error) {
+				return []byte(err.Code()), nil
+			}))
+		}()
+		go func() {
+			defer wg.Done()
+			if _, renderErr := Render(err); renderErr != nil {
+				t.Errorf("unexpected error: %v", renderErr)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+type rendererFunc func(err ChainableError) ([]byte, error)
+
+func (f rendererFunc) Render(err ChainableError) ([]byte, error) {
+	return f(err)
+}
+
+func TestUnwrapAllHandlesPlainError(t *testing.T) {
+	plain := errors.New("plain")
+	all := unwrapAll(plain)
+	if len(all) != 1 || all[0] != plain {
+		t.Fatalf("unexpected unwrapAll result: %+v", all)
+	}
+}
@@ -0,0 +1,86 @@
+package pkg
+
+// raisedPanic is the internal marker wrapping a ChainableError passed to Raise. Try, TryResult
+// and Catch recognize this marker specifically, so any other panic keeps propagating unchanged
+// instead of being mistaken for a managed error.
+type raisedPanic struct {
+	err ChainableError
+}
+
+// wrapAsChainable mirrors From's wrapping rule: pass ChainableError values through unchanged,
+// and lift anything else into an AppFailure carrying the original error as its cause.
+func wrapAsChainable(err error) ChainableError {
+	if chain, ok := err.(ChainableError); ok {
+		return chain
+	}
+	return NewFailure(err.Error(), "unexpected_failure", 500, WithCause(err))
+}
+
+// Raise panics with err wrapped in an internal marker, so that Try, TryResult or a deferred
+// Catch further up the call stack can recover it as a typed ChainableError.
+func Raise(err ChainableError) {
+	panic(raisedPanic{err: err})
+}
+
+// Must returns v when err is nil, and otherwise Raises it (wrapping a plain error the same way
+// From does), letting call sites write straight-line code instead of an if err != nil check.
+func Must[T any](v T, err error) T {
+	if err != nil {
+		Raise(wrapAsChainable(err))
+	}
+	return v
+}
+
+// Try runs fn and recovers any error Raised within it, returning it as a ChainableError. A
+// panic that did not originate from Raise is re-panicked unchanged so genuine bugs still crash.
+func Try(fn func()) (err ChainableError) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		marker, ok := r.(raisedPanic)
+		if !ok {
+			panic(r)
+		}
+		err = marker.err
+	}()
+	fn()
+	return nil
+}
+
+// TryResult runs fn and converts any error Raised within it into a failed Result, integrating
+// Raise/Must style code with the Result monad. As with Try, non-Raise panics are re-panicked.
+func TryResult[T any](fn func() T) (res Result[T]) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		marker, ok := r.(raisedPanic)
+		if !ok {
+			panic(r)
+		}
+		res = FailureResult[T](marker.err)
+	}()
+	return Success(fn())
+}
+
+// Catch is meant to be deferred at the top of a function so it can annotate a Raised error -
+// for example with the function name via runtime.Caller - before letting it continue to
+// propagate. It re-panics afterwards so an outer Try/TryResult/Catch still sees the error;
+// panics that did not originate from Raise pass through untouched.
+func Catch(fn func(ChainableError)) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	marker, ok := r.(raisedPanic)
+	if !ok {
+		panic(r)
+	}
+	if fn != nil {
+		fn(marker.err)
+	}
+	panic(marker)
+}
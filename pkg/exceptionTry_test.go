@@ -0,0 +1,133 @@
+package pkg
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMustReturnsValueOnSuccess(t *testing.T) {
+	v := Must(42, nil)
+	if v != 42 {
+		t.Fatalf("unexpected value: %d", v)
+	}
+}
+
+func TestMustRaisesOnError(t *testing.T) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatalf("expected Must to panic on error")
+		}
+		marker, ok := r.(raisedPanic)
+		if !ok {
+			t.Fatalf("expected a raisedPanic marker, got %T", r)
+		}
+		if marker.err.Code() != "E100" {
+			t.Fatalf("unexpected code: %s", marker.err.Code())
+		}
+	}()
+	Must(0, NewError("bad input", "E100", 400))
+}
+
+func TestTryRecoversRaisedError(t *testing.T) {
+	err := Try(func() {
+		Must(0, NewError("bad input", "E101", 400))
+	})
+	if err == nil {
+		t.Fatalf("expected Try to recover the raised error")
+	}
+	if err.Code() != "E101" {
+		t.Fatalf("unexpected code: %s", err.Code())
+	}
+}
+
+func TestTryReturnsNilWhenNoPanic(t *testing.T) {
+	err := Try(func() {})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestTryRepanicsOtherPanics(t *testing.T) {
+	defer func() {
+		r := recover()
+		if r != "boom" {
+			t.Fatalf("expected the original panic to propagate, got %v", r)
+		}
+	}()
+	Try(func() {
+		panic("boom")
+	})
+}
+
+func TestTryResultRecoversRaisedError(t *testing.T) {
+	res := TryResult(func() int {
+		return Must(0, NewError("bad input", "E102", 400))
+	})
+
+	_, err := res.Value()
+	if err == nil {
+		t.Fatalf("expected a failed Result")
+	}
+	if err.Code() != "E102" {
+		t.Fatalf("unexpected code: %s", err.Code())
+	}
+}
+
+func TestTryResultReturnsValueOnSuccess(t *testing.T) {
+	res := TryResult(func() int {
+		return Must(5, nil)
+	})
+
+	val, err := res.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if val != 5 {
+		t.Fatalf("unexpected value: %d", val)
+	}
+}
+
+func TestCatchAnnotatesAndRepanics(t *testing.T) {
+	var annotated string
+
+	run := func() {
+		defer Catch(func(err ChainableError) {
+			annotated = err.Message()
+		})
+		Raise(NewError("wrapped", "E103", 400))
+	}
+
+	func() {
+		defer func() {
+			r := recover()
+			if r == nil {
+				t.Fatalf("expected Catch to re-panic after annotating")
+			}
+			if _, ok := r.(raisedPanic); !ok {
+				t.Fatalf("expected re-panic to still carry the raisedPanic marker, got %T", r)
+			}
+		}()
+		run()
+	}()
+
+	if annotated != "wrapped" {
+		t.Fatalf("expected Catch to observe the raised error, got %q", annotated)
+	}
+}
+
+func TestMustWrapsPlainErrorAsFailure(t *testing.T) {
+	plain := errors.New("plain")
+	err := Try(func() {
+		Must(0, plain)
+	})
+	if err == nil {
+		t.Fatalf("expected Try to recover the wrapped error")
+	}
+	if _, ok := err.(*AppFailure); !ok {
+		t.Fatalf("expected a plain error to be wrapped as *AppFailure, got %T", err)
+	}
+	if !errors.Is(err, plain) {
+		t.Fatalf("expected the wrapped failure to unwrap to the original error")
+	}
+}
@@ -0,0 +1,133 @@
+// Package grpc bridges pkg.ChainableError with gRPC's status/codes machinery, so the same
+// error can be rendered consistently whether it crosses an HTTP or a gRPC service boundary.
+package grpc
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/TachoMex/tachitogo/pkg"
+)
+
+// errorInfoDomain identifies this package's errors in the ErrorInfo.Domain field attached to
+// every status produced by ToStatus.
+const errorInfoDomain = "tachitogo"
+
+// errorInfoKindKey namespaces the synthetic Kind entry within ErrorInfo.Metadata, so it can't
+// collide with a user-supplied detail key (an application error is free to have its own
+// "kind" detail; only this exact reserved key is reserved).
+const errorInfoKindKey = "tachitogo.kind"
+
+// ToStatus maps err onto the closest gRPC status: its HTTP Status() becomes a codes.Code, and
+// Code()/Kind()/Details() travel along as an attached ErrorInfo detail so FromStatus can
+// reconstruct an equivalent ChainableError on the other side.
+func ToStatus(err pkg.ChainableError) *status.Status {
+	st := status.New(codeFromHTTPStatus(err.Status()), err.Message())
+
+	metadata := make(map[string]string, len(err.Details())+1)
+	for k, v := range err.Details() {
+		metadata[k] = v
+	}
+	metadata[errorInfoKindKey] = string(err.Kind())
+
+	withDetails, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   err.Code(),
+		Domain:   errorInfoDomain,
+		Metadata: metadata,
+	})
+	if detailErr != nil {
+		return st
+	}
+	return withDetails
+}
+
+// FromStatus reconstructs an AppError (or AppFailure, when the attached ErrorInfo recorded
+// KindFailure) from a gRPC status produced by ToStatus.
+func FromStatus(st *status.Status) pkg.ChainableError {
+	httpStatus := httpStatusFromCode(st.Code())
+	code := ""
+	kind := pkg.KindError
+	details := map[string]string{}
+
+	for _, d := range st.Details() {
+		info, ok := d.(*errdetails.ErrorInfo)
+		if !ok || info.GetDomain() != errorInfoDomain {
+			continue
+		}
+		code = info.GetReason()
+		for k, v := range info.GetMetadata() {
+			if k == errorInfoKindKey {
+				kind = pkg.ErrorKind(v)
+				continue
+			}
+			details[k] = v
+		}
+	}
+
+	opts := []pkg.ErrorOption{pkg.WithDetails(details)}
+	if kind == pkg.KindFailure {
+		return pkg.NewFailure(st.Message(), code, httpStatus, opts...)
+	}
+	return pkg.NewError(st.Message(), code, httpStatus, opts...)
+}
+
+// codeFromHTTPStatus maps an HTTP status onto the closest gRPC code.
+func codeFromHTTPStatus(httpStatus int) codes.Code {
+	switch httpStatus {
+	case 400:
+		return codes.InvalidArgument
+	case 401:
+		return codes.Unauthenticated
+	case 403:
+		return codes.PermissionDenied
+	case 404:
+		return codes.NotFound
+	case 409:
+		return codes.AlreadyExists
+	case 412:
+		return codes.FailedPrecondition
+	case 429:
+		return codes.ResourceExhausted
+	case 500:
+		return codes.Internal
+	case 501:
+		return codes.Unimplemented
+	case 503:
+		return codes.Unavailable
+	case 504:
+		return codes.DeadlineExceeded
+	default:
+		return codes.Unknown
+	}
+}
+
+// httpStatusFromCode maps a gRPC code back onto the closest HTTP status.
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return 200
+	case codes.InvalidArgument:
+		return 400
+	case codes.Unauthenticated:
+		return 401
+	case codes.PermissionDenied:
+		return 403
+	case codes.NotFound:
+		return 404
+	case codes.AlreadyExists:
+		return 409
+	case codes.FailedPrecondition:
+		return 412
+	case codes.ResourceExhausted:
+		return 429
+	case codes.Unimplemented:
+		return 501
+	case codes.Unavailable:
+		return 503
+	case codes.DeadlineExceeded:
+		return 504
+	default:
+		return 500
+	}
+}
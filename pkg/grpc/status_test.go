@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/TachoMex/tachitogo/pkg"
+)
+
+func TestToStatusMapsHTTPStatusToCode(t *testing.T) {
+	err := pkg.NewError("widget not found", "not_found", 404, pkg.WithDetail("id", "42"))
+
+	st := ToStatus(err)
+	if st.Code() != codes.NotFound {
+		t.Fatalf("unexpected code: %v", st.Code())
+	}
+	if st.Message() != "widget not found" {
+		t.Fatalf("unexpected message: %q", st.Message())
+	}
+}
+
+func TestFromStatusRoundTripsErrorInfo(t *testing.T) {
+	original := pkg.NewFailure("boom", "db_unavailable", 503, pkg.WithDetail("host", "srv-1"))
+
+	st := ToStatus(original)
+	restored := FromStatus(st)
+
+	if restored.Code() != "db_unavailable" {
+		t.Fatalf("unexpected code: %s", restored.Code())
+	}
+	if restored.Kind() != pkg.KindFailure {
+		t.Fatalf("unexpected kind: %v", restored.Kind())
+	}
+	if restored.Status() != 503 {
+		t.Fatalf("unexpected status: %d", restored.Status())
+	}
+	if restored.Details()["host"] != "srv-1" {
+		t.Fatalf("unexpected details: %+v", restored.Details())
+	}
+}
+
+func TestFromStatusSurvivesUserDetailNamedKind(t *testing.T) {
+	original := pkg.NewFailure("boom", "db_unavailable", 503, pkg.WithDetail("kind", "transient"))
+
+	st := ToStatus(original)
+	restored := FromStatus(st)
+
+	if restored.Kind() != pkg.KindFailure {
+		t.Fatalf("expected a user detail literally named \"kind\" not to clobber the real Kind, got %v", restored.Kind())
+	}
+	if restored.Details()["kind"] != "transient" {
+		t.Fatalf("expected the user-supplied \"kind\" detail to survive the round trip, got %+v", restored.Details())
+	}
+}